@@ -1,12 +1,136 @@
 package config
 
-import "github.com/BurntSushi/toml"
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
 
 func InitNavigator(confPath string) *Config {
 	cfg := &Config{}
-	_, err := toml.DecodeFile(confPath, &cfg)
+	err := Load(confPath, cfg)
 	if err != nil {
-		panic("config.toml is err !!")
+		panic(fmt.Sprintf("config is err !! %v", err))
 	}
 	return cfg
 }
+
+// Load 解析配置文件到任意结构体，业务可内嵌 Config 扩展自己的配置项。
+// yaml 格式下内嵌的 Config 必须带 yaml:",inline" 标签，否则返回错误。
+// out 为 *Config 或内嵌了 Config 的结构体指针时，同时保存原始配置供 Get 读取
+func Load(confPath string, out interface{}) error {
+	if err := load(confPath, out); err != nil {
+		return fmt.Errorf("config %s: %w", confPath, err)
+	}
+	return nil
+}
+
+// unmarshalers 按文件后缀选择解析格式
+var unmarshalers = map[string]func(data []byte, out interface{}) error{
+	".toml": toml.Unmarshal,
+	".json": json.Unmarshal,
+	".yaml": yaml.Unmarshal,
+	".yml":  yaml.Unmarshal,
+}
+
+// load 文件只读取一次，结构体和原始配置从同一份内容解析，保证两者一致
+func load(confPath string, out interface{}) error {
+	ext := strings.ToLower(filepath.Ext(confPath))
+	unmarshal, ok := unmarshalers[ext]
+	if !ok {
+		return fmt.Errorf("unsupported file extension %q", ext)
+	}
+	if ext == ".yaml" || ext == ".yml" {
+		if err := checkYAMLInline(out); err != nil {
+			return err
+		}
+	}
+	data, err := os.ReadFile(confPath)
+	if err != nil {
+		return err
+	}
+	if err = unmarshal(data, out); err != nil {
+		return err
+	}
+	if setter, ok := out.(rawSetter); ok {
+		raw := &rawTree{}
+		if err = unmarshal(data, &raw.values); err != nil {
+			return err
+		}
+		setter.setRaw(raw)
+	}
+	return nil
+}
+
+// rawTree 未脱敏的原始配置，以指针挂在 Config 上，fmt 打印时只输出地址，json/yaml 不会序列化
+type rawTree struct {
+	values map[string]interface{}
+}
+
+// rawSetter 由 *Config 实现，内嵌 Config 的结构体指针同样满足
+type rawSetter interface {
+	setRaw(raw *rawTree)
+}
+
+func (c *Config) setRaw(raw *rawTree) {
+	if c != nil {
+		c.raw = raw
+	}
+}
+
+// Get 按点分路径读取原始配置，如 Get("feature.pay.enable")，
+// 仅对通过 InitNavigator / Load 加载的配置有效
+func (c *Config) Get(path string) (interface{}, bool) {
+	if c == nil || c.raw == nil {
+		return nil, false
+	}
+	var cur interface{} = c.raw.values
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// checkYAMLInline yaml.v3 只展开带 inline 标签的内嵌字段，
+// 内嵌 Config 漏写标签时整段配置会被静默丢弃，这里提前报错
+func checkYAMLInline(out interface{}) error {
+	t := reflect.TypeOf(out)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	configType := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if !field.Anonymous || fieldType != configType {
+			continue
+		}
+		opts := strings.Split(field.Tag.Get("yaml"), ",")[1:]
+		inline := false
+		for _, opt := range opts {
+			inline = inline || opt == "inline"
+		}
+		if !inline {
+			return fmt.Errorf("embedded %s needs a `yaml:\",inline\"` tag", field.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testJSONConf = `{
+	"server": {"name": "server", "addr": ":23200", "env": "production"},
+	"mysql": {
+		"name": "xingyuan",
+		"master": "root:1995@Zxd@tcp(127.0.0.1:3306)/test?charset=utf8mb4&parseTime=true&loc=Local",
+		"slave": "root:1995@Zxd@tcp(127.0.0.1:3306)/test?charset=utf8mb4&parseTime=true&loc=Local"
+	},
+	"redis": {"name": "", "addr": "", "pass_word": "redisinkePassWd", "data_base": 7}
+}`
+
+const testYAMLConf = `server:
+  name: server
+  addr: ":23200"
+  env: production
+mysql:
+  name: xingyuan
+  master: "root:1995@Zxd@tcp(127.0.0.1:3306)/test?charset=utf8mb4&parseTime=true&loc=Local"
+  slave: "root:1995@Zxd@tcp(127.0.0.1:3306)/test?charset=utf8mb4&parseTime=true&loc=Local"
+redis:
+  name: ""
+  addr: ""
+  password: redisinkePassWd
+  database: 7
+`
+
+// writeConf 在临时目录写入配置文件，返回文件路径
+func writeConf(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestInitNavigatorFormats(t *testing.T) {
+	want := InitNavigator("conf.toml")
+	if want.Redis.PassWord != "redisinkePassWd" || want.Redis.DataBase != 7 {
+		t.Fatalf("toml redis = %+v", *want.Redis)
+	}
+
+	for _, path := range []string{
+		writeConf(t, "conf.json", testJSONConf),
+		writeConf(t, "conf.yaml", testYAMLConf),
+	} {
+		got := InitNavigator(path)
+		if !reflect.DeepEqual(got.Server, want.Server) {
+			t.Errorf("%s: server = %+v, want %+v", path, *got.Server, *want.Server)
+		}
+		if !reflect.DeepEqual(got.Mysql, want.Mysql) {
+			t.Errorf("%s: mysql differs from toml", path)
+		}
+		if !reflect.DeepEqual(got.Redis, want.Redis) {
+			t.Errorf("%s: redis differs from toml", path)
+		}
+	}
+}
+
+func TestLoadUnknownExtension(t *testing.T) {
+	path := writeConf(t, "conf.ini", "")
+	if err := Load(path, &Config{}); err == nil {
+		t.Fatal("expected error for .ini")
+	}
+}
+
+// appConfig 模拟业务内嵌 Config 并扩展自己的配置项
+type appConfig struct {
+	Config  `yaml:",inline"`
+	Feature struct {
+		Name   string `toml:"name" yaml:"name"`
+		Enable bool   `toml:"enable" yaml:"enable"`
+		Limit  int    `toml:"limit" yaml:"limit"`
+	} `toml:"feature" yaml:"feature"`
+}
+
+func TestLoadCustomStruct(t *testing.T) {
+	conf, err := os.ReadFile("conf.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{
+		writeConf(t, "app.toml", string(conf)+"\n[feature]\nname = \"pay\"\nenable = true\nlimit = 3\n"),
+		writeConf(t, "app.yaml", testYAMLConf+"feature:\n  name: pay\n  enable: true\n  limit: 3\n"),
+	}
+	for _, path := range paths {
+		var app appConfig
+		if err := Load(path, &app); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if app.Server == nil || app.Server.Addr != ":23200" {
+			t.Errorf("%s: server = %+v", path, app.Server)
+		}
+		if app.Redis == nil || app.Redis.DataBase != 7 {
+			t.Errorf("%s: redis = %+v", path, app.Redis)
+		}
+		if app.Feature.Name != "pay" || !app.Feature.Enable || app.Feature.Limit != 3 {
+			t.Errorf("%s: feature = %+v", path, app.Feature)
+		}
+	}
+}
+
+func TestLoadYAMLEmbedWithoutInline(t *testing.T) {
+	path := writeConf(t, "app.yaml", testYAMLConf)
+	var app struct {
+		Config
+	}
+	err := Load(path, &app)
+	if err == nil || !strings.Contains(err.Error(), "inline") {
+		t.Fatalf("err = %v, want error about the missing inline tag", err)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.toml")
+	err := Load(path, &Config{})
+	if err == nil || !strings.Contains(err.Error(), path) {
+		t.Fatalf("err = %v, want error naming %s", err, path)
+	}
+}
+
+func TestConfigPrintNoSecrets(t *testing.T) {
+	cfg := InitNavigator("conf.toml")
+	var app struct {
+		Config
+		Extra string `toml:"extra"`
+	}
+	if err := Load("conf.toml", &app); err != nil {
+		t.Fatal(err)
+	}
+
+	jsonCfg, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := []string{
+		string(jsonCfg),
+		fmt.Sprintf("%v", cfg), fmt.Sprintf("%+v", cfg), fmt.Sprintf("%#v", cfg),
+		fmt.Sprintf("%v", *cfg), fmt.Sprintf("%+v", *cfg), fmt.Sprintf("%#v", *cfg),
+		fmt.Sprintf("%+v", app), fmt.Sprintf("%#v", app),
+	}
+	for _, out := range outputs {
+		for _, secret := range []string{"1995@Zxd", "redisinkePassWd"} {
+			if strings.Contains(out, secret) {
+				t.Errorf("secret %q leaked in %s", secret, out)
+			}
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	conf, err := os.ReadFile("conf.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := writeConf(t, "app.toml", string(conf)+"\n[feature.pay]\nenable = true\n")
+
+	var app struct {
+		Config
+	}
+	if err := Load(path, &app); err != nil {
+		t.Fatal(err)
+	}
+	for _, cfg := range []*Config{InitNavigator(path), &app.Config} {
+		if v, ok := cfg.Get("feature.pay.enable"); !ok || v != true {
+			t.Errorf("Get(feature.pay.enable) = %v, %v", v, ok)
+		}
+		if v, ok := cfg.Get("mysql.name"); !ok || v != "xingyuan" {
+			t.Errorf("Get(mysql.name) = %v, %v", v, ok)
+		}
+		for _, path := range []string{"feature.pay.missing", "mysql.name.x", "missing"} {
+			if v, ok := cfg.Get(path); ok {
+				t.Errorf("Get(%s) = %v, want not found", path, v)
+			}
+		}
+	}
+	if _, ok := (&Config{}).Get("mysql.name"); ok {
+		t.Error("Get on an unloaded Config should report not found")
+	}
+	var nilConfig *Config
+	if _, ok := nilConfig.Get("mysql.name"); ok {
+		t.Error("Get on a nil Config should report not found")
+	}
+}
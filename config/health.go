@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/redis/go-redis/v9"
+)
+
+// Ping 用 master DSN 建连并探活，超时由 ctx 控制，可用于就绪检查
+func (m *MysqlConfig) Ping(ctx context.Context) error {
+	db, err := sql.Open("mysql", m.Master)
+	if err != nil {
+		return fmt.Errorf("mysql %s: open: %w", m.Name, err)
+	}
+	defer db.Close()
+	if err = db.PingContext(ctx); err != nil {
+		return fmt.Errorf("mysql %s: ping: %w", m.Name, err)
+	}
+	return nil
+}
+
+// Ping 连接 redis 并执行 PING，不做重试，超时由 ctx 控制
+func (r *RedisConfig) Ping(ctx context.Context) error {
+	client := redis.NewClient(&redis.Options{
+		Addr:       r.Addr,
+		Password:   r.PassWord,
+		DB:         r.DataBase,
+		MaxRetries: -1,
+		// 默认读写超时只看 ReadTimeout/WriteTimeout(3s)，需显式开启才遵守 ctx 的 deadline
+		ContextTimeoutEnabled: true,
+	})
+	defer client.Close()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis %s(%s): ping: %w", r.Name, r.Addr, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// silentListener 接受连接但从不响应，模拟挂死的依赖
+func silentListener(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// closedAddr 返回一个没有监听的地址
+func closedAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return addr
+}
+
+func TestPingDeadline(t *testing.T) {
+	addr := silentListener(t)
+	pings := map[string]func(ctx context.Context) error{
+		"mysql test":  (&MysqlConfig{Name: "test", Master: "root:secret@tcp(" + addr + ")/test"}).Ping,
+		"redis cache": (&RedisConfig{Name: "cache", Addr: addr}).Ping,
+	}
+	for prefix, ping := range pings {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		start := time.Now()
+		err := ping(ctx)
+		elapsed := time.Since(start)
+		cancel()
+
+		if err == nil {
+			t.Fatalf("%s: expected error", prefix)
+		}
+		if !strings.HasPrefix(err.Error(), prefix) {
+			t.Errorf("error %q does not name %q", err, prefix)
+		}
+		if elapsed > time.Second {
+			t.Errorf("%s: returned after %v, deadline was 200ms", prefix, elapsed)
+		}
+	}
+}
+
+func TestPingRefused(t *testing.T) {
+	addr := closedAddr(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := (&MysqlConfig{Name: "test", Master: "root:secret@tcp(" + addr + ")/test"}).Ping(ctx)
+	if err == nil || !strings.HasPrefix(err.Error(), "mysql test") {
+		t.Errorf("mysql ping error = %v", err)
+	}
+	if err != nil && strings.Contains(err.Error(), "secret") {
+		t.Errorf("mysql ping error leaks password: %v", err)
+	}
+	err = (&RedisConfig{Name: "cache", Addr: addr}).Ping(ctx)
+	if err == nil || !strings.HasPrefix(err.Error(), "redis cache") {
+		t.Errorf("redis ping error = %v", err)
+	}
+}
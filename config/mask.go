@@ -0,0 +1,82 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const maskedValue = "***"
+
+// 别名类型不带 String/MarshalJSON，避免格式化时递归
+type (
+	mysqlConfig MysqlConfig
+	redisConfig RedisConfig
+)
+
+// Masked 返回 master/slave DSN 中密码被替换为 *** 的副本
+func (m *MysqlConfig) Masked() MysqlConfig {
+	c := *m
+	c.Master = maskDSN(c.Master)
+	c.Slave = maskDSN(c.Slave)
+	return c
+}
+
+func (m MysqlConfig) String() string {
+	return fmt.Sprintf("%+v", mysqlConfig(m.Masked()))
+}
+
+// GoString 保证 %#v 同样输出脱敏后的内容
+func (m MysqlConfig) GoString() string {
+	c := m.Masked()
+	return fmt.Sprintf("config.MysqlConfig{Name:%q, Master:%q, Slave:%q}", c.Name, c.Master, c.Slave)
+}
+
+func (m MysqlConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mysqlConfig(m.Masked()))
+}
+
+// Masked 返回密码被替换为 *** 的副本
+func (r *RedisConfig) Masked() RedisConfig {
+	c := *r
+	if c.PassWord != "" {
+		c.PassWord = maskedValue
+	}
+	return c
+}
+
+func (r RedisConfig) String() string {
+	return fmt.Sprintf("%+v", redisConfig(r.Masked()))
+}
+
+// GoString 保证 %#v 同样输出脱敏后的内容
+func (r RedisConfig) GoString() string {
+	c := r.Masked()
+	return fmt.Sprintf("config.RedisConfig{Name:%q, Addr:%q, PassWord:%q, DataBase:%d}", c.Name, c.Addr, c.PassWord, c.DataBase)
+}
+
+func (r RedisConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redisConfig(r.Masked()))
+}
+
+// maskDSN 借助 go-sql-driver 的解析结果定位密码，只替换原串中的密码部分，其余内容保持原样；
+// 解析失败时无法确定密码位置，整体替换为 ***
+func maskDSN(dsn string) string {
+	if dsn == "" {
+		return dsn
+	}
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return maskedValue
+	}
+	if cfg.Passwd == "" {
+		return dsn
+	}
+	userInfo := cfg.User + ":" + cfg.Passwd + "@"
+	if !strings.HasPrefix(dsn, userInfo) {
+		return maskedValue
+	}
+	return cfg.User + ":" + maskedValue + dsn[len(userInfo)-1:]
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMysqlConfigMasked(t *testing.T) {
+	// 参数顺序和取默认值的参数都应原样保留
+	const rest = "@tcp(127.0.0.1:3306)/test?parseTime=true&charset=utf8mb4&allowNativePasswords=true&loc=Local"
+	passwords := []string{"1995@Zxd", "pa?ss", "pa/ss", "p@s?s/w"}
+	for _, password := range passwords {
+		dsn := "root:" + password + rest
+		cfg := &MysqlConfig{Name: "test", Master: dsn, Slave: dsn}
+
+		masked := cfg.Masked()
+		if want := "root:***" + rest; masked.Master != want || masked.Slave != want {
+			t.Errorf("%q: masked = %q, want %q", password, masked.Master, want)
+		}
+		if cfg.Master != dsn {
+			t.Errorf("%q: Masked modified the original config", password)
+		}
+
+		b, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputs := []string{
+			masked.Master, masked.Slave, string(b),
+			cfg.String(), fmt.Sprintf("%v", cfg), fmt.Sprintf("%+v", *cfg),
+			fmt.Sprintf("%#v", cfg), fmt.Sprintf("%#v", *cfg),
+		}
+		for _, out := range outputs {
+			if strings.Contains(out, password) {
+				t.Errorf("%q: password leaked in %q", password, out)
+			}
+		}
+	}
+}
+
+func TestMaskDSN(t *testing.T) {
+	tests := []struct {
+		dsn, want string
+	}{
+		{"", ""},
+		{"root@tcp(127.0.0.1:3306)/test", "root@tcp(127.0.0.1:3306)/test"},
+		{"root:secret@tcp(127.0.0.1:3306)/test", "root:***@tcp(127.0.0.1:3306)/test"},
+		{"root:secret@/test?b=2&a=1", "root:***@/test?b=2&a=1"},
+		{"root:secret", maskedValue},
+	}
+	for _, tt := range tests {
+		if got := maskDSN(tt.dsn); got != tt.want {
+			t.Errorf("maskDSN(%q) = %q, want %q", tt.dsn, got, tt.want)
+		}
+	}
+}
+
+func TestRedisConfigMasked(t *testing.T) {
+	cfg := &RedisConfig{Addr: "127.0.0.1:6379", PassWord: "redis?p@ss/word", DataBase: 7}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := []string{
+		cfg.Masked().PassWord, string(b), cfg.String(), fmt.Sprintf("%+v", *cfg),
+		fmt.Sprintf("%#v", cfg), fmt.Sprintf("%#v", *cfg),
+	}
+	for _, out := range outputs {
+		if strings.Contains(out, cfg.PassWord) {
+			t.Errorf("password leaked in %q", out)
+		}
+	}
+	if cfg.Masked().PassWord != maskedValue {
+		t.Errorf("masked password = %q", cfg.Masked().PassWord)
+	}
+}
@@ -4,6 +4,8 @@ type Config struct {
 	Server *Server
 	Mysql  *MysqlConfig
 	Redis  *RedisConfig
+
+	raw *rawTree
 }
 
 type Server struct {
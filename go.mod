@@ -3,11 +3,17 @@ module github.com/orglode/hades
 go 1.20
 
 require (
+	github.com/BurntSushi/toml v1.2.1
+	github.com/go-sql-driver/mysql v1.7.1
+	github.com/redis/go-redis/v9 v9.0.5
 	github.com/smartwalle/alipay/v3 v3.2.0
 	go.uber.org/zap v1.24.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/smartwalle/crypto4go v1.0.2 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
@@ -0,0 +1,53 @@
+package logging
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock 时间来源，测试时可替换为固定时间
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// clockHolder 保证 atomic.Value 中存放的类型一致
+type clockHolder struct {
+	c Clock
+}
+
+// clock 未设置时使用系统时间
+var clock atomic.Value
+
+func now() time.Time {
+	if h, ok := clock.Load().(clockHolder); ok {
+		return h.c.Now()
+	}
+	return time.Now()
+}
+
+// SetClock 替换日志使用的时间来源，传 nil 恢复系统时间，可与日志写入并发调用。
+// 包级 error/info/debug/access 日志的文件名在包初始化时已确定，不受影响；
+// 之后创建的 Logger（NewLogger、For）文件名和所有日志的时间都会使用新的 Clock
+func SetClock(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	clock.Store(clockHolder{c: c})
+}
+
+// zapClock 让 zap 记录日志时间时也走 clock
+type zapClock struct{}
+
+func (zapClock) Now() time.Time {
+	return now()
+}
+
+func (zapClock) NewTicker(d time.Duration) *time.Ticker {
+	return time.NewTicker(d)
+}
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestSetClock(t *testing.T) {
+	frozen := time.Date(2001, 2, 3, 4, 5, 6, 0, time.Local)
+	SetClock(fixedClock(frozen))
+	t.Cleanup(func() { SetClock(nil) })
+
+	file := logFileName(initLevelCommon)
+	if want := "./logs/common_20010203.log"; file != want {
+		t.Fatalf("logFileName = %q, want %q", file, want)
+	}
+
+	NewLogger().Info("frozen clock")
+	entry := findEntry(t, file, "frozen clock")
+	if entry == nil {
+		t.Fatalf("entry not found in %s", file)
+	}
+	if got, want := entry["time"], "2001-02-03 04:05:06.000"; got != want {
+		t.Fatalf("time = %v, want %v", got, want)
+	}
+}
+
+func TestSetClockConcurrent(t *testing.T) {
+	t.Cleanup(func() { SetClock(nil) })
+	l := NewLogger()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetClock(fixedClock(time.Unix(int64(i), 0)))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			l.Debug("concurrent")
+		}
+	}()
+	wg.Wait()
+}
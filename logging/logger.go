@@ -3,6 +3,7 @@ package logging
 import (
 	"context"
 	"go.uber.org/zap"
+	"sort"
 )
 
 var loggings map[string]*Logger
@@ -28,15 +29,23 @@ type Logger struct {
 	defaultLogging *zap.SugaredLogger
 }
 
+// newInitLogger 供包级函数使用，调用链多一层 Errorf -> Logger.Errorf，所以跳过 2 层
 func newInitLogger(initLevelConf string) *Logger {
 	return &Logger{
-		defaultLogging: zap.New(initCoreEncoder(initLevelConf)).WithOptions(zap.AddCaller(), zap.AddCallerSkip(1)).Sugar(),
+		defaultLogging: zap.New(initCoreEncoder(initLevelConf)).WithOptions(zap.AddCaller(), zap.AddCallerSkip(2), zap.WithClock(zapClock{})).Sugar(),
 	}
 }
 
 func NewLogger() *Logger {
 	return &Logger{
-		defaultLogging: zap.New(initCoreEncoder(initLevelCommon)).WithOptions(zap.AddCaller(), zap.AddCallerSkip(1)).Sugar(),
+		defaultLogging: zap.New(initCoreEncoder(initLevelCommon)).WithOptions(zap.AddCaller(), zap.AddCallerSkip(1), zap.WithClock(zapClock{})).Sugar(),
+	}
+}
+
+// WithCallerSkip 返回额外跳过 skip 层调用栈的 Logger，业务自己封装日志函数时使用，保证 caller 指向真实调用处
+func (l *Logger) WithCallerSkip(skip int) *Logger {
+	return &Logger{
+		defaultLogging: l.defaultLogging.WithOptions(zap.AddCallerSkip(skip)),
 	}
 }
 
@@ -78,6 +87,33 @@ func Accessf(key string, params ...interface{}) {
 	loggings[initLevelAccess].Infof(key, params...)
 }
 
+// ErrorKV/InfoKV/DebugKV 以 key-value 对输出结构化字段，如 InfoKV("pay ok", "order_id", id, "amount", 100)
+func ErrorKV(msg string, kv ...interface{}) {
+	loggings[initLevelError].ErrorKV(msg, kv...)
+}
+
+func InfoKV(msg string, kv ...interface{}) {
+	loggings[initLevelInfo].InfoKV(msg, kv...)
+}
+
+func DebugKV(msg string, kv ...interface{}) {
+	loggings[initLevelDebug].DebugKV(msg, kv...)
+}
+
+// Access 结构化访问日志，fields 按 key 排序后作为 JSON 字段写入 access 日志
+func Access(fields map[string]interface{}) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kv := make([]interface{}, 0, len(keys)*2)
+	for _, k := range keys {
+		kv = append(kv, k, fields[k])
+	}
+	loggings[initLevelAccess].InfoKV("access", kv...)
+}
+
 func (l *Logger) Errorf(key string, params ...interface{}) {
 	l.defaultLogging.Errorf(key, params...)
 }
@@ -92,3 +128,27 @@ func (l *Logger) Debugf(key string, params ...interface{}) {
 func (l *Logger) Panicf(key string, params ...interface{}) {
 	l.defaultLogging.Panicf(key, params...)
 }
+
+func (l *Logger) ErrorKV(msg string, kv ...interface{}) {
+	l.defaultLogging.Errorw(msg, kvPairs(kv)...)
+}
+
+func (l *Logger) InfoKV(msg string, kv ...interface{}) {
+	l.defaultLogging.Infow(msg, kvPairs(kv)...)
+}
+
+func (l *Logger) DebugKV(msg string, kv ...interface{}) {
+	l.defaultLogging.Debugw(msg, kvPairs(kv)...)
+}
+
+// kvPairs 末尾落单的元素按 slog 的约定记在 !BADKEY 下，
+// 否则 zap 会另外输出一条 caller 指向本包的 "Ignored key without a value." 错误日志
+func kvPairs(kv []interface{}) []interface{} {
+	if len(kv)%2 == 0 {
+		return kv
+	}
+	last := len(kv) - 1
+	pairs := make([]interface{}, 0, len(kv)+1)
+	pairs = append(pairs, kv[:last]...)
+	return append(pairs, "!BADKEY", kv[last])
+}
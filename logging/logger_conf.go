@@ -17,8 +17,12 @@ func initCoreEncoder(initLevel string) zapcore.Core {
 	encoderConfig.EncodeTime = timeLayout
 	// level 大写
 	encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
-	//初始化
-	level := zap.NewAtomicLevelAt(zap.DebugLevel)
+	//按日志类型设置级别，access/common 不在映射内，全量输出
+	levelValue, ok := initLeve()[initLevel]
+	if !ok {
+		levelValue = zapcore.DebugLevel
+	}
+	level := zap.NewAtomicLevelAt(levelValue)
 
 	writeSyncer, _ := os.Create(logFileName(initLevel))
 	//初始化core
@@ -35,7 +39,7 @@ func logFileName(initLog string) string {
 	if !ok {
 		return ""
 	}
-	newFile := dirInfo + initLog + "_" + time.Now().Format("20060102") + ".log"
+	newFile := dirInfo + initLog + "_" + now().Format("20060102") + ".log"
 	return newFile
 }
 
@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"testing"
+)
+
+func TestLevelFilter(t *testing.T) {
+	errorLogger := loggings[initLevelError]
+	errorLogger.Debug("filter debug on error")
+	errorLogger.Info("filter info on error")
+	errorLogger.Error("filter error on error")
+	loggings[initLevelInfo].Debug("filter debug on info")
+
+	errorFile := logFileName(initLevelError)
+	for _, msg := range []string{"filter debug on error", "filter info on error"} {
+		if findEntry(t, errorFile, msg) != nil {
+			t.Errorf("%q written to the error logger", msg)
+		}
+	}
+	if findEntry(t, errorFile, "filter error on error") == nil {
+		t.Error("error entry missing from the error logger")
+	}
+	if findEntry(t, logFileName(initLevelInfo), "filter debug on info") != nil {
+		t.Error("debug entry written to the info logger")
+	}
+}
+
+func TestLevelFilterUnmapped(t *testing.T) {
+	// access/common 不在 initLeve 映射里，保持全量输出
+	l := NewLogger()
+	l.Debug("unmapped debug")
+	if findEntry(t, logFileName(initLevelCommon), "unmapped debug") == nil {
+		t.Error("debug entry missing from the common logger")
+	}
+}
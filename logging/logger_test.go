@@ -0,0 +1,166 @@
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	// 包初始化时在当前目录创建了 ./logs/
+	os.RemoveAll("./logs/")
+	os.Exit(code)
+}
+
+// readEntries 读取日志文件中的全部 JSON 行
+func readEntries(t *testing.T, file string) []map[string]interface{} {
+	t.Helper()
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatalf("open %s: %v", file, err)
+	}
+	defer f.Close()
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("%s: invalid json line %q: %v", file, scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read %s: %v", file, err)
+	}
+	return entries
+}
+
+// findEntry 按 message 查找日志，找不到返回 nil
+func findEntry(t *testing.T, file, msg string) map[string]interface{} {
+	t.Helper()
+	for _, entry := range readEntries(t, file) {
+		if entry["message"] == msg {
+			return entry
+		}
+	}
+	return nil
+}
+
+// callerAt 返回调用处偏移 offset 行的 caller，格式与 zap ShortCallerEncoder 一致
+func callerAt(offset int) string {
+	_, file, line, _ := runtime.Caller(1)
+	return fmt.Sprintf("%s/%s:%d", filepath.Base(filepath.Dir(file)), filepath.Base(file), line+offset)
+}
+
+// wrappedInfo 模拟业务自己封装的一层日志函数
+func wrappedInfo(l *Logger, msg string) {
+	l.WithCallerSkip(1).Info(msg)
+}
+
+func TestWithCallerSkip(t *testing.T) {
+	l := NewLogger()
+	wrappedInfo(l, "wrapped caller")
+	want := callerAt(-1)
+
+	entry := findEntry(t, logFileName(initLevelCommon), "wrapped caller")
+	if entry == nil {
+		t.Fatal("entry not found")
+	}
+	if entry["caller"] != want {
+		t.Fatalf("caller = %v, want %v", entry["caller"], want)
+	}
+}
+
+func TestPackageFuncCaller(t *testing.T) {
+	tests := []struct {
+		level string
+		log   func(key string, params ...interface{})
+	}{
+		{initLevelError, Errorf},
+		{initLevelInfo, Infof},
+		{initLevelDebug, Debugf},
+		{initLevelAccess, Accessf},
+	}
+	for _, tt := range tests {
+		tt.log("package caller %s", tt.level)
+		want := callerAt(-1)
+
+		msg := "package caller " + tt.level
+		entry := findEntry(t, logFileName(tt.level), msg)
+		if entry == nil {
+			t.Fatalf("%s: entry not found", tt.level)
+		}
+		if entry["caller"] != want {
+			t.Errorf("%s: caller = %v, want %v", tt.level, entry["caller"], want)
+		}
+	}
+}
+
+func TestAccess(t *testing.T) {
+	Access(map[string]interface{}{"request_id": "req-1", "status": 200, "path": "/pay"})
+	want := callerAt(-1)
+
+	entry := findEntry(t, logFileName(initLevelAccess), "access")
+	if entry == nil {
+		t.Fatal("entry not found")
+	}
+	if entry["request_id"] != "req-1" || entry["status"] != float64(200) || entry["path"] != "/pay" {
+		t.Errorf("fields = %v", entry)
+	}
+	if entry["caller"] != want {
+		t.Errorf("caller = %v, want %v", entry["caller"], want)
+	}
+}
+
+func TestKV(t *testing.T) {
+	tests := []struct {
+		level string
+		log   func(msg string, kv ...interface{})
+	}{
+		{initLevelError, ErrorKV},
+		{initLevelInfo, InfoKV},
+		{initLevelDebug, DebugKV},
+	}
+	for _, tt := range tests {
+		msg := "kv " + tt.level
+		tt.log(msg, "order_id", "A001", "amount", 100)
+		want := callerAt(-1)
+
+		entry := findEntry(t, logFileName(tt.level), msg)
+		if entry == nil {
+			t.Fatalf("%s: entry not found", tt.level)
+		}
+		if entry["order_id"] != "A001" || entry["amount"] != float64(100) {
+			t.Errorf("%s: fields missing in %v", tt.level, entry)
+		}
+		if entry["caller"] != want {
+			t.Errorf("%s: caller = %v, want %v", tt.level, entry["caller"], want)
+		}
+	}
+}
+
+func TestKVOddLength(t *testing.T) {
+	InfoKV("kv odd", "order_id", "A002", "dangling")
+	want := callerAt(-1)
+
+	file := logFileName(initLevelInfo)
+	entry := findEntry(t, file, "kv odd")
+	if entry == nil {
+		t.Fatal("entry not found")
+	}
+	if entry["order_id"] != "A002" || entry["!BADKEY"] != "dangling" {
+		t.Errorf("fields = %v", entry)
+	}
+	if entry["caller"] != want {
+		t.Errorf("caller = %v, want %v", entry["caller"], want)
+	}
+	if findEntry(t, file, "Ignored key without a value.") != nil {
+		t.Error("zap reported the dangling key as a separate error entry")
+	}
+}